@@ -0,0 +1,36 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// HTTP GET: /readyz.
+func TestHTTPReadyz(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), requestTimeout)
+	defer cancel()
+
+	resp, err := doWebRequestWithTimeout(ctx, http.MethodGet, readyPath, http.NoBody)
+	if err != nil {
+		t.Fatalf("TestHTTPReadyz: failed to send request: %v", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("TestHTTPReadyz: expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	report := parseJSON[struct {
+		Checks map[string]struct {
+			Status string `json:"status"`
+		} `json:"checks"`
+	}](t, resp)
+
+	for _, name := range []string{"postgres", "rabbitmq", "nats"} {
+		if got := report.Checks[name].Status; got != "up" {
+			t.Errorf("TestHTTPReadyz: expected checks.%s.status %q, got %q", name, "up", got)
+		}
+	}
+}
@@ -29,6 +29,7 @@ const (
 	// Attempts connection.
 	httpURL        = "http://" + host + ":8080"
 	healthPath     = httpURL + "/healthz"
+	readyPath      = httpURL + "/readyz"
 	requestTimeout = 5 * time.Second
 
 	// HTTP REST.
@@ -19,6 +19,7 @@ import (
 	"github.com/evrone/go-clean-template/internal/usecase/translation"
 	"github.com/evrone/go-clean-template/internal/usecase/user"
 	"github.com/evrone/go-clean-template/pkg/grpcserver"
+	"github.com/evrone/go-clean-template/pkg/healthcheck"
 	"github.com/evrone/go-clean-template/pkg/httpserver"
 	"github.com/evrone/go-clean-template/pkg/jwt"
 	"github.com/evrone/go-clean-template/pkg/logger"
@@ -53,7 +54,7 @@ func initUseCases(pg *postgres.Postgres, jwtManager *jwt.Manager) useCases {
 	}
 }
 
-func initServers(cfg *config.Config, uc useCases, jwtManager *jwt.Manager, l logger.Interface) servers {
+func initServers(cfg *config.Config, uc useCases, jwtManager *jwt.Manager, health *healthcheck.Health, l logger.Interface) servers {
 	// RabbitMQ RPC Server
 	rmqRouter := amqprpc.NewRouter(uc.translation, uc.user, uc.task, jwtManager, l)
 
@@ -80,7 +81,7 @@ func initServers(cfg *config.Config, uc useCases, jwtManager *jwt.Manager, l log
 
 	// HTTP Server
 	httpServer := httpserver.New(l, httpserver.Port(cfg.HTTP.Port), httpserver.Prefork(cfg.HTTP.UsePreforkMode))
-	restapi.NewRouter(httpServer.App, cfg, uc.translation, uc.user, uc.task, jwtManager, l)
+	restapi.NewRouter(httpServer.App, cfg, uc.translation, uc.user, uc.task, jwtManager, health, l)
 
 	return servers{
 		rmq:  rmqServer,
@@ -148,11 +149,18 @@ func Run(cfg *config.Config) {
 	}
 	defer pg.Close()
 
+	// Health checks
+	health := healthcheck.New()
+	health.Register("postgres", pg.Pool.Ping)
+
 	// JWT
 	jwtManager := jwt.New(cfg.JWT.Secret, cfg.JWT.TokenExpiry)
 
 	uc := initUseCases(pg, jwtManager)
-	s := initServers(cfg, uc, jwtManager, l)
+	s := initServers(cfg, uc, jwtManager, health, l)
+	health.Register("rabbitmq", s.rmq.Ping)
+	health.Register("nats", s.nats.Ping)
+
 	s.startServers()
 	s.waitForShutdown(l)
 }
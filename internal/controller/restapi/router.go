@@ -9,6 +9,7 @@ import (
 	"github.com/evrone/go-clean-template/internal/controller/restapi/middleware"
 	v1 "github.com/evrone/go-clean-template/internal/controller/restapi/v1"
 	"github.com/evrone/go-clean-template/internal/usecase"
+	"github.com/evrone/go-clean-template/pkg/healthcheck"
 	"github.com/evrone/go-clean-template/pkg/jwt"
 	"github.com/evrone/go-clean-template/pkg/logger"
 	"github.com/gofiber/fiber/v2"
@@ -26,7 +27,7 @@ import (
 //	@securityDefinitions.apikey BearerAuth
 //	@in header
 //	@name Authorization
-func NewRouter(app *fiber.App, cfg *config.Config, t usecase.Translation, u usecase.User, tk usecase.Task, jwtManager *jwt.Manager, l logger.Interface) {
+func NewRouter(app *fiber.App, cfg *config.Config, t usecase.Translation, u usecase.User, tk usecase.Task, jwtManager *jwt.Manager, h *healthcheck.Health, l logger.Interface) {
 	// Options
 	app.Use(middleware.Logger(l))
 	app.Use(middleware.Recovery(l))
@@ -43,8 +44,17 @@ func NewRouter(app *fiber.App, cfg *config.Config, t usecase.Translation, u usec
 		app.Get("/swagger/*", swagger.HandlerDefault)
 	}
 
-	// K8s probe
+	// K8s probes
 	app.Get("/healthz", func(ctx *fiber.Ctx) error { return ctx.SendStatus(http.StatusOK) })
+	app.Get("/livez", func(ctx *fiber.Ctx) error { return ctx.Status(http.StatusOK).JSON(h.Live()) })
+	app.Get("/readyz", func(ctx *fiber.Ctx) error {
+		report := h.Ready(ctx.UserContext())
+		if report.Status == healthcheck.StatusDown {
+			return ctx.Status(http.StatusServiceUnavailable).JSON(report)
+		}
+
+		return ctx.Status(http.StatusOK).JSON(report)
+	})
 
 	// Routers
 	apiV1Group := app.Group("/v1")
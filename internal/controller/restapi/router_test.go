@@ -0,0 +1,82 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/evrone/go-clean-template/config"
+	"github.com/evrone/go-clean-template/internal/controller/restapi"
+	"github.com/evrone/go-clean-template/pkg/healthcheck"
+	"github.com/evrone/go-clean-template/pkg/jwt"
+	"github.com/evrone/go-clean-template/pkg/logger"
+	"github.com/goccy/go-json"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+func TestRouter_Probes(t *testing.T) {
+	t.Parallel()
+
+	unavailable := func(context.Context) error { return errUnavailable }
+
+	tests := []struct {
+		name           string
+		path           string
+		register       func(h *healthcheck.Health)
+		expectedCode   int
+		expectedStatus healthcheck.Status
+	}{
+		{
+			name:           "readyz down",
+			path:           "/readyz",
+			register:       func(h *healthcheck.Health) { h.Register("postgres", unavailable) },
+			expectedCode:   http.StatusServiceUnavailable,
+			expectedStatus: healthcheck.StatusDown,
+		},
+		{
+			name:           "readyz degraded",
+			path:           "/readyz",
+			register:       func(h *healthcheck.Health) { h.RegisterOptional("cache", unavailable) },
+			expectedCode:   http.StatusOK,
+			expectedStatus: healthcheck.StatusDegraded,
+		},
+		{
+			name:           "livez ignores dependencies",
+			path:           "/livez",
+			register:       func(h *healthcheck.Health) { h.Register("postgres", unavailable) },
+			expectedCode:   http.StatusOK,
+			expectedStatus: healthcheck.StatusUp,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := healthcheck.New()
+			tc.register(h)
+
+			app := fiber.New()
+			restapi.NewRouter(app, &config.Config{}, nil, nil, nil, jwt.New("test-secret", time.Hour), h, logger.New("error"))
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, tc.path, http.NoBody))
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.expectedCode, resp.StatusCode)
+
+			var report healthcheck.Report
+
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+			assert.Equal(t, tc.expectedStatus, report.Status)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Package healthcheck implements liveness and readiness checks.
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	_defaultTimeout  = time.Second
+	_defaultCacheTTL = time.Second
+)
+
+var (
+	// ErrCheckPanic is returned in a check result when the checker panicked.
+	ErrCheckPanic = errors.New("health check panicked")
+	// ErrCheckRunning is returned in a check result when the previous call has not returned yet.
+	ErrCheckRunning = errors.New("previous check still running")
+)
+
+// Status -.
+type Status string
+
+// Check statuses.
+const (
+	StatusUp       Status = "up"
+	StatusDegraded Status = "degraded"
+	StatusDown     Status = "down"
+)
+
+// Checker reports whether a dependency is usable.
+type Checker func(ctx context.Context) error
+
+// CheckResult -.
+type CheckResult struct {
+	Status Status `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report -.
+type Report struct {
+	Status Status                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+type check struct {
+	name     string
+	checker  Checker
+	critical bool
+	timeout  time.Duration
+
+	// running is set while a checker call is in flight, including one abandoned after its timeout.
+	running atomic.Bool
+}
+
+// Health aggregates dependency checks into a single readiness report.
+type Health struct {
+	mu       sync.Mutex
+	checks   []*check
+	gen      uint64
+	cached   Report
+	cachedAt time.Time
+	inflight chan struct{}
+
+	timeout  time.Duration
+	cacheTTL time.Duration
+}
+
+// New -.
+func New(opts ...Option) *Health {
+	h := &Health{
+		timeout:  _defaultTimeout,
+		cacheTTL: _defaultCacheTTL,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Register adds a critical check: its failure makes the service not ready.
+func (h *Health) Register(name string, checker Checker, opts ...CheckOption) {
+	h.add(name, checker, true, opts)
+}
+
+// RegisterOptional adds a non-critical check: its failure only degrades the service.
+func (h *Health) RegisterOptional(name string, checker Checker, opts ...CheckOption) {
+	h.add(name, checker, false, opts)
+}
+
+func (h *Health) add(name string, checker Checker, critical bool, opts []CheckOption) {
+	c := &check{name: name, checker: checker, critical: critical}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.checks = append(h.checks, c)
+	h.gen++
+	h.cachedAt = time.Time{}
+}
+
+// Live reports that the process is running. It does not touch dependencies.
+func (h *Health) Live() Report {
+	return Report{Status: StatusUp}
+}
+
+// Ready runs all registered checks, reusing the last report within the cache TTL.
+// Concurrent callers share a single in-flight run instead of starting their own.
+func (h *Health) Ready(ctx context.Context) Report {
+	h.mu.Lock()
+
+	if !h.cachedAt.IsZero() && time.Since(h.cachedAt) < h.cacheTTL {
+		report := h.cached
+		h.mu.Unlock()
+
+		return report
+	}
+
+	if done := h.inflight; done != nil {
+		h.mu.Unlock()
+
+		// Bounded by h.timeout: every check gives up after it.
+		<-done
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		return h.cached
+	}
+
+	done := make(chan struct{})
+	h.inflight = done
+	checks := slices.Clone(h.checks)
+	gen := h.gen
+	h.mu.Unlock()
+
+	// The report is shared with other callers, so the caller's cancellation must not leak into it.
+	report := h.run(context.WithoutCancel(ctx), checks)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.cached = report
+	if gen == h.gen {
+		h.cachedAt = time.Now()
+	}
+
+	h.inflight = nil
+
+	close(done)
+
+	return report
+}
+
+func (h *Health) run(ctx context.Context, checks []*check) Report {
+	results := make([]CheckResult, len(checks))
+
+	var wg sync.WaitGroup
+
+	for i, c := range checks {
+		wg.Go(func() {
+			results[i] = h.runCheck(ctx, c)
+		})
+	}
+
+	wg.Wait()
+
+	report := Report{
+		Status: StatusUp,
+		Checks: make(map[string]CheckResult, len(checks)),
+	}
+
+	for i, c := range checks {
+		report.Checks[c.name] = results[i]
+
+		if results[i].Status == StatusUp {
+			continue
+		}
+
+		if c.critical {
+			report.Status = StatusDown
+		} else if report.Status == StatusUp {
+			report.Status = StatusDegraded
+		}
+	}
+
+	return report
+}
+
+func (h *Health) runCheck(ctx context.Context, c *check) CheckResult {
+	// A checker that ignores its context stays stuck; never stack another call on top of it.
+	if !c.running.CompareAndSwap(false, true) {
+		return c.failed(ErrCheckRunning)
+	}
+
+	timeout := c.timeout
+	if timeout <= 0 {
+		timeout = h.timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Buffered, so a checker abandoned after the timeout can still send and exit.
+	result := make(chan error, 1)
+
+	go func() {
+		err := c.call(ctx)
+		c.running.Store(false)
+
+		result <- err
+	}()
+
+	var err error
+
+	select {
+	case err = <-result:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		return c.failed(err)
+	}
+
+	return CheckResult{Status: StatusUp}
+}
+
+func (c *check) call(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrCheckPanic, r)
+		}
+	}()
+
+	return c.checker(ctx)
+}
+
+func (c *check) failed(err error) CheckResult {
+	status := StatusDegraded
+	if c.critical {
+		status = StatusDown
+	}
+
+	return CheckResult{Status: status, Error: err.Error()}
+}
@@ -0,0 +1,243 @@
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/evrone/go-clean-template/pkg/healthcheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errCheck = errors.New("check failed")
+
+func ok(context.Context) error { return nil }
+
+func fail(context.Context) error { return errCheck }
+
+func TestHealth_Live(t *testing.T) {
+	t.Parallel()
+
+	h := healthcheck.New()
+	h.Register("db", fail)
+
+	assert.Equal(t, healthcheck.StatusUp, h.Live().Status)
+}
+
+func TestHealth_Ready(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		critical healthcheck.Checker
+		optional healthcheck.Checker
+		expected healthcheck.Status
+	}{
+		{name: "all up", critical: ok, optional: ok, expected: healthcheck.StatusUp},
+		{name: "optional down", critical: ok, optional: fail, expected: healthcheck.StatusDegraded},
+		{name: "critical down", critical: fail, optional: ok, expected: healthcheck.StatusDown},
+		{name: "both down", critical: fail, optional: fail, expected: healthcheck.StatusDown},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := healthcheck.New(healthcheck.CacheTTL(0))
+			h.Register("db", tc.critical)
+			h.RegisterOptional("cache", tc.optional)
+
+			report := h.Ready(context.Background())
+			assert.Equal(t, tc.expected, report.Status)
+			require.Len(t, report.Checks, 2)
+		})
+	}
+}
+
+func TestHealth_Ready_ReportsError(t *testing.T) {
+	t.Parallel()
+
+	h := healthcheck.New()
+	h.Register("db", fail)
+
+	report := h.Ready(context.Background())
+	require.Contains(t, report.Checks, "db")
+	assert.Equal(t, healthcheck.StatusDown, report.Checks["db"].Status)
+	assert.Equal(t, errCheck.Error(), report.Checks["db"].Error)
+}
+
+func TestHealth_Ready_Timeout(t *testing.T) {
+	t.Parallel()
+
+	h := healthcheck.New(healthcheck.Timeout(10 * time.Millisecond))
+	h.Register("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	report := h.Ready(context.Background())
+	assert.Equal(t, healthcheck.StatusDown, report.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["slow"].Error)
+}
+
+func TestHealth_Ready_Cached(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	h := healthcheck.New(healthcheck.CacheTTL(time.Hour))
+	h.Register("db", func(context.Context) error {
+		calls.Add(1)
+
+		return nil
+	})
+
+	h.Ready(context.Background())
+	h.Ready(context.Background())
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestHealth_Ready_CheckerIgnoresContext(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 50 * time.Millisecond
+
+	block := make(chan struct{})
+
+	t.Cleanup(func() { close(block) })
+
+	h := healthcheck.New(healthcheck.Timeout(timeout))
+	h.Register("stuck", func(context.Context) error {
+		<-block
+
+		return nil
+	})
+
+	start := time.Now()
+	report := h.Ready(context.Background())
+
+	assert.Less(t, time.Since(start), 10*timeout)
+	assert.Equal(t, healthcheck.StatusDown, report.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["stuck"].Error)
+}
+
+func TestHealth_Ready_ConcurrentCallersDoNotBlock(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 50 * time.Millisecond
+
+	var calls atomic.Int32
+
+	block := make(chan struct{})
+
+	t.Cleanup(func() { close(block) })
+
+	h := healthcheck.New(healthcheck.Timeout(timeout), healthcheck.CacheTTL(time.Hour))
+	h.Register("stuck", func(context.Context) error {
+		calls.Add(1)
+		<-block
+
+		return nil
+	})
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+
+	for range 5 {
+		wg.Go(func() {
+			report := h.Ready(context.Background())
+			assert.Equal(t, healthcheck.StatusDown, report.Status)
+		})
+	}
+
+	wg.Go(func() { h.RegisterOptional("cache", ok) })
+
+	wg.Wait()
+
+	assert.Less(t, time.Since(start), 10*timeout)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestHealth_Ready_StuckCheckerStartedOnce(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	block := make(chan struct{})
+
+	t.Cleanup(func() { close(block) })
+
+	h := healthcheck.New(healthcheck.Timeout(10*time.Millisecond), healthcheck.CacheTTL(0))
+	h.Register("stuck", func(context.Context) error {
+		calls.Add(1)
+		<-block
+
+		return nil
+	})
+
+	h.Ready(context.Background())
+
+	for range 3 {
+		report := h.Ready(context.Background())
+		assert.Equal(t, healthcheck.StatusDown, report.Status)
+		assert.Equal(t, healthcheck.ErrCheckRunning.Error(), report.Checks["stuck"].Error)
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestHealth_Ready_CheckTimeout(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 50 * time.Millisecond
+
+	wait := func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	h := healthcheck.New(healthcheck.Timeout(time.Hour))
+	h.Register("db", wait, healthcheck.CheckTimeout(timeout))
+	h.RegisterOptional("cache", wait, healthcheck.CheckTimeout(timeout))
+
+	start := time.Now()
+	report := h.Ready(context.Background())
+
+	assert.Less(t, time.Since(start), 10*timeout)
+	assert.Equal(t, healthcheck.StatusDown, report.Status)
+	assert.Equal(t, healthcheck.StatusDegraded, report.Checks["cache"].Status)
+}
+
+func TestHealth_Ready_CallerCancelled(t *testing.T) {
+	t.Parallel()
+
+	h := healthcheck.New(healthcheck.CacheTTL(time.Hour))
+	h.Register("db", func(ctx context.Context) error { return ctx.Err() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Equal(t, healthcheck.StatusUp, h.Ready(ctx).Status)
+	assert.Equal(t, healthcheck.StatusUp, h.Ready(context.Background()).Status)
+}
+
+func TestHealth_Ready_CheckerPanics(t *testing.T) {
+	t.Parallel()
+
+	h := healthcheck.New()
+	h.Register("db", ok)
+	h.RegisterOptional("cache", func(context.Context) error { panic("boom") })
+
+	report := h.Ready(context.Background())
+	assert.Equal(t, healthcheck.StatusDegraded, report.Status)
+	assert.Equal(t, healthcheck.StatusDegraded, report.Checks["cache"].Status)
+	assert.Contains(t, report.Checks["cache"].Error, healthcheck.ErrCheckPanic.Error())
+}
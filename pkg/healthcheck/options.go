@@ -0,0 +1,30 @@
+package healthcheck
+
+import "time"
+
+// Option -.
+type Option func(*Health)
+
+// Timeout sets the default timeout for checks registered without CheckTimeout.
+func Timeout(timeout time.Duration) Option {
+	return func(h *Health) {
+		h.timeout = timeout
+	}
+}
+
+// CacheTTL -.
+func CacheTTL(ttl time.Duration) Option {
+	return func(h *Health) {
+		h.cacheTTL = ttl
+	}
+}
+
+// CheckOption -.
+type CheckOption func(*check)
+
+// CheckTimeout overrides the Health timeout for a single check.
+func CheckTimeout(timeout time.Duration) CheckOption {
+	return func(c *check) {
+		c.timeout = timeout
+	}
+}
@@ -107,6 +107,15 @@ func (s *Server) Notify() <-chan error {
 	return s.notify
 }
 
+// Ping reports whether the NATS connection is established.
+func (s *Server) Ping(context.Context) error {
+	if !s.connection.IsConnected() {
+		return natsrpc.ErrConnectionClosed
+	}
+
+	return nil
+}
+
 // Shutdown -.
 func (s *Server) Shutdown() error {
 	var shutdownErrors []error
@@ -9,6 +9,8 @@ var (
 	ErrInternalServer = errors.New("internal server error")
 	// ErrBadHandler -.
 	ErrBadHandler = errors.New("unregistered handler")
+	// ErrConnectionClosed -.
+	ErrConnectionClosed = errors.New("connection closed")
 )
 
 // Success -.
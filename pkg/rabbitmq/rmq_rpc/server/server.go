@@ -96,6 +96,15 @@ func (s *Server) Notify() <-chan error {
 	return s.notify
 }
 
+// Ping reports whether the RabbitMQ connection is still open.
+func (s *Server) Ping(context.Context) error {
+	if s.conn.Connection == nil || s.conn.Connection.IsClosed() {
+		return rmqrpc.ErrConnectionClosed
+	}
+
+	return nil
+}
+
 // Shutdown -.
 func (s *Server) Shutdown() error {
 	var shutdownErrors []error